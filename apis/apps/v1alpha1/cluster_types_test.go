@@ -255,6 +255,36 @@ spec:
 	}
 }
 
+func TestClusterPhasePredicates(t *testing.T) {
+	cases := []struct {
+		phase    ClusterPhase
+		ready    bool
+		failed   bool
+		terminal bool
+	}{
+		{CreatingClusterPhase, false, false, false},
+		{RunningClusterPhase, true, false, true},
+		{UpdatingClusterPhase, false, false, false},
+		{StoppingClusterPhase, false, false, false},
+		{StoppedClusterPhase, false, false, true},
+		{DeletingClusterPhase, false, false, false},
+		{FailedClusterPhase, false, true, true},
+		{AbnormalClusterPhase, false, true, true},
+		{"", false, false, false},
+	}
+	for _, c := range cases {
+		if c.phase.IsReady() != c.ready {
+			t.Errorf("phase %q: expected IsReady %t", c.phase, c.ready)
+		}
+		if c.phase.IsFailed() != c.failed {
+			t.Errorf("phase %q: expected IsFailed %t", c.phase, c.failed)
+		}
+		if c.phase.IsTerminal() != c.terminal {
+			t.Errorf("phase %q: expected IsTerminal %t", c.phase, c.terminal)
+		}
+	}
+}
+
 func TestGetMessage(t *testing.T) {
 	podKey := "Pod/test-01"
 	compStatus := ClusterComponentStatus{
@@ -263,6 +263,29 @@ const (
 	AbnormalClusterPhase ClusterPhase = "Abnormal"
 )
 
+// IsReady checks if the cluster phase indicates that all components are running properly.
+func (p ClusterPhase) IsReady() bool {
+	return p == RunningClusterPhase
+}
+
+// IsFailed checks if the cluster phase indicates that some components have failed, i.e. `Failed` or `Abnormal`.
+// Note that `Abnormal` is deliberately treated as failed here even though GetClusterUpRunningPhases counts it as up:
+// the cluster may still serve requests, but troubleshooting is required.
+func (p ClusterPhase) IsFailed() bool {
+	return p == FailedClusterPhase || p == AbnormalClusterPhase
+}
+
+// IsTerminal checks if the cluster phase is a terminal phase, that is, the cluster is not in the process of
+// creating, updating, stopping or deleting.
+func (p ClusterPhase) IsTerminal() bool {
+	switch p {
+	case RunningClusterPhase, StoppedClusterPhase, FailedClusterPhase, AbnormalClusterPhase:
+		return true
+	default:
+		return false
+	}
+}
+
 // ClusterComponentPhase defines the phase of a cluster component as represented in cluster.status.components.phase field.
 //
 // +enum